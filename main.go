@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/google/go-github/v66/github"
+	"github.com/underopsco/enforce-security-sla-action/report"
 	"github.com/underopsco/go-action/pkg/action"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -29,11 +33,132 @@ func main() {
 }
 
 type Action struct {
-	Token    string `action:"token"`
-	Critical int    `action:"critical-threshold"`
-	High     int    `action:"high-threshold"`
-	Medium   int    `action:"medium-threshold"`
-	Low      int    `action:"low-threshold"`
+	Token      string   `action:"token"`
+	Critical   int      `action:"critical-threshold"`
+	High       int      `action:"high-threshold"`
+	Medium     int      `action:"medium-threshold"`
+	Low        int      `action:"low-threshold"`
+	Timeout    int      `action:"timeout"`
+	ReportPath string   `action:"report-path"`
+	Assignees  []string `action:"assignees"`
+
+	CVSSCritical int    `action:"cvss-critical-threshold"`
+	CVSSHigh     int    `action:"cvss-high-threshold"`
+	CWEOverrides string `action:"cwe-overrides"`
+
+	IgnoreDismissed *bool    `action:"ignore-dismissed"`
+	IgnoreTools     []string `action:"ignore-tools"`
+	IgnorePaths     []string `action:"ignore-paths"`
+	IgnoreGHSAIDs   []string `action:"ignore-ghsa-ids"`
+	MinAgeDays      int      `action:"min-age-days"`
+}
+
+// alertFilters builds the chain of AlertFilter rules from the action's
+// ignore-* and min-age-days inputs.
+func (a *Action) alertFilters() []AlertFilter {
+	ignoreDismissed := true
+	if a.IgnoreDismissed != nil {
+		ignoreDismissed = *a.IgnoreDismissed
+	}
+
+	return []AlertFilter{
+		dismissedFilter{enabled: ignoreDismissed},
+		newToolFilter(a.IgnoreTools),
+		pathFilter{globs: a.IgnorePaths},
+		newGHSAFilter(a.IgnoreGHSAIDs),
+		minAgeFilter{minAge: time.Duration(a.MinAgeDays*24) * time.Hour},
+	}
+}
+
+// CVSS base score bands used to bucket Dependabot alerts when a score is
+// present, per the FIRST CVSS v3 qualitative severity rating scale.
+const (
+	cvssCriticalBand = 9.0
+	cvssHighBand     = 7.0
+)
+
+// slaConfig bundles every threshold input needed to decide whether an
+// alert breaches its SLA.
+type slaConfig struct {
+	criticalThreshold     time.Duration
+	highThreshold         time.Duration
+	mediumThreshold       time.Duration
+	lowThreshold          time.Duration
+	cvssCriticalThreshold time.Duration
+	cvssHighThreshold     time.Duration
+	cweOverrides          map[string]time.Duration
+}
+
+// parseCWEOverrides parses the `cwe-overrides` YAML input, a mapping of
+// CWE ID (e.g. "CWE-798") to an SLA threshold in days.
+func parseCWEOverrides(raw string) (map[string]time.Duration, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var days map[string]int
+	if err := yaml.Unmarshal([]byte(raw), &days); err != nil {
+		return nil, fmt.Errorf("parsing cwe-overrides: %w", err)
+	}
+
+	overrides := make(map[string]time.Duration, len(days))
+	for cwe, d := range days {
+		overrides[cwe] = time.Duration(d*24) * time.Hour
+	}
+
+	return overrides, nil
+}
+
+const (
+	securitySLALabel   = "security-sla"
+	trackingIssueTitle = "Security SLA breaches"
+)
+
+// defaultTimeout bounds how long the run's GitHub API calls (alert fetching
+// and the review, check run or tracking issue that follows) are allowed to
+// take when the `timeout` input isn't set, so a stuck API call can't hang
+// the workflow.
+const defaultTimeout = 60 * time.Second
+
+// Severity is a normalized security alert severity, ordered from least to
+// most urgent so it can be compared or used to index per-bucket thresholds.
+type Severity int
+
+const (
+	SeverityLow Severity = iota
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityCritical:
+		return "critical"
+	case SeverityHigh:
+		return "high"
+	case SeverityMedium:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// normalizeSeverity maps the assorted severity strings returned by the
+// CodeScanning, Dependabot and SecretScanning APIs onto the four SLA
+// buckets this action enforces. Unrecognized values fall back to
+// SeverityLow rather than silently escaping the SLA check entirely.
+func normalizeSeverity(raw string) Severity {
+	switch strings.ToLower(raw) {
+	case "critical":
+		return SeverityCritical
+	case "high", "error":
+		return SeverityHigh
+	case "medium", "moderate", "warning":
+		return SeverityMedium
+	default:
+		return SeverityLow
+	}
 }
 
 func (a *Action) Run() error {
@@ -43,7 +168,14 @@ func (a *Action) Run() error {
 		Level: slog.LevelDebug,
 	})))
 
-	ctx := context.Background()
+	timeout := defaultTimeout
+	if a.Timeout > 0 {
+		timeout = time.Duration(a.Timeout) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
 	startTime := time.Now()
 
 	criticalThreshold := time.Duration(a.Critical*24) * time.Hour
@@ -51,6 +183,21 @@ func (a *Action) Run() error {
 	mediumThreshold := time.Duration(a.Medium*24) * time.Hour
 	lowThreshold := time.Duration(a.Low*24) * time.Hour
 
+	cweOverrides, err := parseCWEOverrides(a.CWEOverrides)
+	if err != nil {
+		return err
+	}
+
+	cfg := slaConfig{
+		criticalThreshold:     criticalThreshold,
+		highThreshold:         highThreshold,
+		mediumThreshold:       mediumThreshold,
+		lowThreshold:          lowThreshold,
+		cvssCriticalThreshold: time.Duration(a.CVSSCritical*24) * time.Hour,
+		cvssHighThreshold:     time.Duration(a.CVSSHigh*24) * time.Hour,
+		cweOverrides:          cweOverrides,
+	}
+
 	event, err := action.GetEvent()
 	if err != nil {
 		return err
@@ -59,15 +206,24 @@ func (a *Action) Run() error {
 	var (
 		prNumber  int
 		prHeadSHA string
+		hasPR     bool
 	)
 
 	switch event := event.(type) {
 	case *github.PullRequestEvent:
 		prNumber = event.GetNumber()
 		prHeadSHA = event.PullRequest.Head.GetSHA()
+		hasPR = true
 	case *github.PullRequestTargetEvent:
 		prNumber = event.GetNumber()
 		prHeadSHA = event.PullRequest.Head.GetSHA()
+		hasPR = true
+	case *github.WorkflowDispatchEvent, *github.PushEvent:
+		// No PR to review or check-run against: breaches are reported via
+		// a tracking issue instead. `schedule:` isn't supported here —
+		// go-action's GetEvent doesn't decode schedule payloads, so there's
+		// no event value to type-switch on for it.
+		hasPR = false
 	default:
 		return fmt.Errorf("unexpected event type: %T", event)
 	}
@@ -87,53 +243,44 @@ func (a *Action) Run() error {
 		return err
 	}
 
-	if len(alerts) == 0 {
-		_, _, err := ghClient.PullRequests.CreateReview(
-			ctx,
-			action.Context.RepositoryOwner,
-			action.Context.RepositoryName,
-			prNumber,
-			&github.PullRequestReviewRequest{
-				Event: github.String("APPROVE"),
-			},
-		)
-		if err != nil {
-			return err
-		}
-
-		_, _, err = ghClient.Checks.CreateCheckRun(
-			ctx,
-			action.Context.RepositoryOwner,
-			action.Context.RepositoryName,
-			github.CreateCheckRunOptions{
-				Name:        checkRunName,
-				HeadSHA:     prHeadSHA,
-				Status:      github.String("completed"),
-				Conclusion:  github.String("success"),
-				StartedAt:   &github.Timestamp{Time: startTime},
-				CompletedAt: &github.Timestamp{Time: time.Now()},
-				Output: &github.CheckRunOutput{
-					Title:   github.String(checkRunSuccessTitle),
-					Summary: github.String(checkRunFailureText),
-				},
-			},
-		)
-		return err
-	}
+	alerts = applyAlertFilters(alerts, a.alertFilters())
 
-	breached := filterBreachedAlerts(
-		alerts,
-		criticalThreshold,
-		highThreshold,
-		mediumThreshold,
-		lowThreshold,
-	)
+	// Note: alerts may be empty here (e.g. a clean repo). filterBreachedAlerts
+	// and buildReport handle that the same as any other zero-breach run, so
+	// the outputs and report below are always emitted rather than skipped.
+	breached := filterBreachedAlerts(alerts, cfg)
 
 	slog.Info("Alerts found",
 		slog.Int("total", len(alerts)),
 		slog.Int("breached", len(breached)))
 
+	breachedBySeverity := countBySeverity(breached)
+	action.SetOutput("breached-critical", fmt.Sprintf("%d", breachedBySeverity[SeverityCritical]))
+	action.SetOutput("breached-high", fmt.Sprintf("%d", breachedBySeverity[SeverityHigh]))
+	action.SetOutput("breached-medium", fmt.Sprintf("%d", breachedBySeverity[SeverityMedium]))
+	action.SetOutput("breached-low", fmt.Sprintf("%d", breachedBySeverity[SeverityLow]))
+	action.SetOutput("total-alerts", fmt.Sprintf("%d", len(alerts)))
+	action.SetOutput("breached-alerts", fmt.Sprintf("%d", len(breached)))
+
+	rep := buildReport(alerts, breached, cfg)
+
+	summary, err := rep.CompactBase64()
+	if err != nil {
+		return err
+	}
+	action.SetOutput("summary", summary)
+
+	if a.ReportPath != "" {
+		if err := rep.WriteFile(a.ReportPath); err != nil {
+			return err
+		}
+	}
+
 	if len(breached) == 0 {
+		if !hasPR {
+			return a.reconcileTrackingIssue(ctx, action.Context.RepositoryOwner, action.Context.RepositoryName, nil, nil)
+		}
+
 		_, _, err := ghClient.PullRequests.CreateReview(
 			ctx,
 			action.Context.RepositoryOwner,
@@ -160,13 +307,17 @@ func (a *Action) Run() error {
 				CompletedAt: &github.Timestamp{Time: time.Now()},
 				Output: &github.CheckRunOutput{
 					Title:   github.String(checkRunSuccessTitle),
-					Summary: github.String(checkRunFailureText),
+					Summary: github.String(checkRunSuccessText),
 				},
 			},
 		)
 		return err
 	}
 
+	if !hasPR {
+		return a.reconcileTrackingIssue(ctx, action.Context.RepositoryOwner, action.Context.RepositoryName, breached, breachedBySeverity)
+	}
+
 	_, _, err = ghClient.PullRequests.CreateReview(
 		ctx,
 		action.Context.RepositoryOwner,
@@ -192,7 +343,7 @@ func (a *Action) Run() error {
 			Conclusion: github.String("failure"),
 			Output: &github.CheckRunOutput{
 				Title:   github.String(fmt.Sprintf(checkRunFailureTitle, len(breached))),
-				Summary: github.String(fmt.Sprintf(checkRunFailureText, len(breached), len(alerts))),
+				Summary: github.String(fmt.Sprintf(checkRunFailureText, len(breached), len(alerts)) + "\n\n" + severityBreakdownTable(breachedBySeverity)),
 			},
 			StartedAt:   &github.Timestamp{Time: startTime},
 			CompletedAt: &github.Timestamp{Time: time.Now()},
@@ -203,90 +354,252 @@ func (a *Action) Run() error {
 
 type Alert struct {
 	Kind      string
-	Severity  string
+	Severity  Severity
 	Link      string
 	CreatedAt time.Time
+
+	// CVSSScore and CWEs are only populated for Dependabot alerts, which
+	// carry a full SecurityAdvisory; zero/nil otherwise.
+	CVSSScore float64
+	CWEs      []string
+
+	// Tool and Path are only populated for CodeScanning alerts. GHSAID is
+	// only populated for Dependabot alerts. Dismissed is only meaningful
+	// for Dependabot, which exposes AutoDismissedAt.
+	Tool      string
+	Path      string
+	GHSAID    string
+	Dismissed bool
 }
 
+// fetchRepoAlerts fans out the three alert sources concurrently so a repo
+// with all of them enabled doesn't pay sequential API latency, then
+// concatenates the results. Each source paginates through every page of
+// results rather than stopping at the first one.
 func fetchRepoAlerts(ctx context.Context, owner, name string) ([]*Alert, error) {
-	var alerts []*Alert
+	var codeScanningAlerts, dependabotAlerts, secretScanningAlerts []*Alert
 
-	codeScanningAlerts, _, err := ghClient.CodeScanning.ListAlertsForRepo(
-		ctx, owner, name,
-		&github.AlertListOptions{
-			State: "open",
-			ListOptions: github.ListOptions{
-				PerPage: 100,
-			},
-		},
-	)
-	if err != nil && !isDisabledError(err) {
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		alerts, err := fetchCodeScanningAlerts(ctx, owner, name)
+		if err != nil {
+			return err
+		}
+		codeScanningAlerts = alerts
+		return nil
+	})
+
+	g.Go(func() error {
+		alerts, err := fetchDependabotAlerts(ctx, owner, name)
+		if err != nil {
+			return err
+		}
+		dependabotAlerts = alerts
+		return nil
+	})
+
+	g.Go(func() error {
+		alerts, err := fetchSecretScanningAlerts(ctx, owner, name)
+		if err != nil {
+			return err
+		}
+		secretScanningAlerts = alerts
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
 
-	for _, a := range codeScanningAlerts {
-		alerts = append(alerts, &Alert{
-			Kind:      "CodeScanning",
-			Severity:  a.GetRuleSeverity(),
-			Link:      a.GetHTMLURL(),
-			CreatedAt: a.GetCreatedAt().Time,
-		})
+	alerts := make([]*Alert, 0, len(codeScanningAlerts)+len(dependabotAlerts)+len(secretScanningAlerts))
+	alerts = append(alerts, codeScanningAlerts...)
+	alerts = append(alerts, dependabotAlerts...)
+	alerts = append(alerts, secretScanningAlerts...)
+
+	return alerts, nil
+}
+
+func fetchCodeScanningAlerts(ctx context.Context, owner, name string) ([]*Alert, error) {
+	var alerts []*Alert
+
+	opts := &github.AlertListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 100},
 	}
 
-	dependabotAlerts, _, err := ghClient.Dependabot.ListRepoAlerts(
-		ctx, owner, name,
-		&github.ListAlertsOptions{
-			State: github.String("open"),
-			ListOptions: github.ListOptions{
-				PerPage: 100,
-			},
-		},
-	)
-	if err != nil && !isDisabledError(err) {
-		return nil, err
+	for {
+		page, resp, err := ghClient.CodeScanning.ListAlertsForRepo(ctx, owner, name, opts)
+		if err != nil {
+			if isDisabledError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, a := range page {
+			alerts = append(alerts, &Alert{
+				Kind:      "CodeScanning",
+				Severity:  normalizeSeverity(a.GetRuleSeverity()),
+				Link:      a.GetHTMLURL(),
+				CreatedAt: a.GetCreatedAt().Time,
+				Tool:      a.GetTool().GetName(),
+				Path:      a.GetMostRecentInstance().GetLocation().GetPath(),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
 	}
 
-	for _, a := range dependabotAlerts {
-		alerts = append(alerts, &Alert{
-			Kind:      "Dependabot",
-			Severity:  a.SecurityAdvisory.GetSeverity(),
-			Link:      a.GetHTMLURL(),
-			CreatedAt: a.GetCreatedAt().Time,
-		})
+	return alerts, nil
+}
+
+func fetchDependabotAlerts(ctx context.Context, owner, name string) ([]*Alert, error) {
+	var alerts []*Alert
+
+	opts := &github.ListAlertsOptions{
+		State:       github.String("open"),
+		ListOptions: github.ListOptions{PerPage: 100},
 	}
 
-	secretScanningAlerts, _, err := ghClient.SecretScanning.ListAlertsForRepo(
-		ctx, owner, name,
-		&github.SecretScanningAlertListOptions{
-			State: "open",
-			ListOptions: github.ListOptions{
-				PerPage: 100,
-			},
-		},
-	)
-	if err != nil && !isDisabledError(err) {
-		return nil, err
+	for {
+		page, resp, err := ghClient.Dependabot.ListRepoAlerts(ctx, owner, name, opts)
+		if err != nil {
+			if isDisabledError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, a := range page {
+			var cwes []string
+			if adv := a.GetSecurityAdvisory(); adv != nil {
+				cwes = make([]string, 0, len(adv.CWEs))
+				for _, cwe := range adv.CWEs {
+					cwes = append(cwes, cwe.GetCWEID())
+				}
+			}
+
+			var cvssScore float64
+			if s := a.GetSecurityAdvisory().GetCVSS().GetScore(); s != nil {
+				cvssScore = *s
+			}
+
+			alerts = append(alerts, &Alert{
+				Kind:      "Dependabot",
+				Severity:  normalizeSeverity(a.GetSecurityAdvisory().GetSeverity()),
+				Link:      a.GetHTMLURL(),
+				CreatedAt: a.GetCreatedAt().Time,
+				CVSSScore: cvssScore,
+				CWEs:      cwes,
+				GHSAID:    a.GetSecurityAdvisory().GetGHSAID(),
+				Dismissed: a.AutoDismissedAt != nil,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
 	}
 
-	for _, a := range secretScanningAlerts {
-		alerts = append(alerts, &Alert{
-			Kind:      "SecretScanning",
-			Severity:  "critical",
-			Link:      a.GetHTMLURL(),
-			CreatedAt: a.GetCreatedAt().Time,
-		})
+	return alerts, nil
+}
+
+func fetchSecretScanningAlerts(ctx context.Context, owner, name string) ([]*Alert, error) {
+	var alerts []*Alert
+
+	opts := &github.SecretScanningAlertListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		page, resp, err := ghClient.SecretScanning.ListAlertsForRepo(ctx, owner, name, opts)
+		if err != nil {
+			if isDisabledError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, a := range page {
+			alerts = append(alerts, &Alert{
+				Kind:      "SecretScanning",
+				Severity:  SeverityCritical,
+				Link:      a.GetHTMLURL(),
+				CreatedAt: a.GetCreatedAt().Time,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
 	}
 
 	return alerts, nil
 }
 
-func filterBreachedAlerts(alerts []*Alert, criticalThreshold, highThreshold, mediumThreshold, lowThreshold time.Duration) []*Alert {
+// thresholdForSeverity picks the SLA threshold matching an alert's
+// normalized severity bucket.
+func thresholdForSeverity(s Severity, criticalThreshold, highThreshold, mediumThreshold, lowThreshold time.Duration) time.Duration {
+	switch s {
+	case SeverityCritical:
+		return criticalThreshold
+	case SeverityHigh:
+		return highThreshold
+	case SeverityMedium:
+		return mediumThreshold
+	default:
+		return lowThreshold
+	}
+}
+
+// thresholdForAlert resolves the SLA threshold for an alert, in order of
+// precedence from most to least specific:
+//
+//  1. cwe-overrides: an exact match on one of the alert's CWEs wins
+//     regardless of severity label or CVSS score (e.g. CWE-798 hardcoded
+//     credentials gets a tight SLA no matter how it was scored).
+//  2. The alert's CVSS base score, bucketed into the critical (>= 9.0) or
+//     high (>= 7.0) bands, when a score is present AND the matching
+//     cvss-*-threshold input is configured. An unconfigured band (input
+//     left at its zero value) falls through to the severity label rather
+//     than enforcing a bogus 0-day SLA.
+//  3. The alert's normalized GitHub severity label (the coarse bucket).
+func thresholdForAlert(a *Alert, cfg slaConfig) time.Duration {
+	for _, cwe := range a.CWEs {
+		if d, ok := cfg.cweOverrides[cwe]; ok {
+			return d
+		}
+	}
+
+	switch {
+	case a.CVSSScore >= cvssCriticalBand:
+		if cfg.cvssCriticalThreshold > 0 {
+			return cfg.cvssCriticalThreshold
+		}
+	case a.CVSSScore >= cvssHighBand:
+		if cfg.cvssHighThreshold > 0 {
+			return cfg.cvssHighThreshold
+		}
+	}
+
+	return thresholdForSeverity(a.Severity, cfg.criticalThreshold, cfg.highThreshold, cfg.mediumThreshold, cfg.lowThreshold)
+}
+
+func filterBreachedAlerts(alerts []*Alert, cfg slaConfig) []*Alert {
 	var breached []*Alert
 
 	for _, a := range alerts {
-		s := time.Since(a.CreatedAt)
+		age := time.Since(a.CreatedAt)
+		threshold := thresholdForAlert(a, cfg)
 
-		if s > criticalThreshold || s > highThreshold || s > mediumThreshold || s > lowThreshold {
+		if age > threshold {
 			breached = append(breached, a)
 		}
 	}
@@ -294,6 +607,173 @@ func filterBreachedAlerts(alerts []*Alert, criticalThreshold, highThreshold, med
 	return breached
 }
 
+// countBySeverity tallies alerts per normalized severity bucket so callers
+// can report a per-severity breakdown.
+func countBySeverity(alerts []*Alert) map[Severity]int {
+	counts := map[Severity]int{
+		SeverityCritical: 0,
+		SeverityHigh:     0,
+		SeverityMedium:   0,
+		SeverityLow:      0,
+	}
+	for _, a := range alerts {
+		counts[a.Severity]++
+	}
+	return counts
+}
+
+// buildReport assembles the stable JSON report document for this run,
+// marking each alert as breached or not and recording the threshold it
+// was judged against.
+func buildReport(alerts, breached []*Alert, cfg slaConfig) *report.Report {
+	breachedSet := make(map[*Alert]bool, len(breached))
+	for _, a := range breached {
+		breachedSet[a] = true
+	}
+
+	bySeverity := map[string]int{}
+	byKind := map[string]int{}
+	alertReports := make([]report.Alert, 0, len(alerts))
+
+	for _, a := range alerts {
+		bySeverity[a.Severity.String()]++
+		byKind[a.Kind]++
+
+		threshold := thresholdForAlert(a, cfg)
+
+		alertReports = append(alertReports, report.Alert{
+			Kind:          a.Kind,
+			Severity:      a.Severity.String(),
+			Link:          a.Link,
+			CreatedAt:     a.CreatedAt,
+			AgeDays:       int(time.Since(a.CreatedAt).Hours() / 24),
+			ThresholdDays: int(threshold.Hours() / 24),
+			Breached:      breachedSet[a],
+		})
+	}
+
+	return &report.Report{
+		Total:      len(alerts),
+		Breached:   len(breached),
+		BySeverity: bySeverity,
+		ByKind:     byKind,
+		Alerts:     alertReports,
+	}
+}
+
+// severityBreakdownTable renders a Markdown table of breached alert counts
+// per severity bucket for inclusion in the check run summary.
+func severityBreakdownTable(counts map[Severity]int) string {
+	var sb strings.Builder
+	sb.WriteString("| Severity | Breached |\n")
+	sb.WriteString("| --- | --- |\n")
+	for _, s := range []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow} {
+		fmt.Fprintf(&sb, "| %s | %d |\n", s, counts[s])
+	}
+	return sb.String()
+}
+
+// reconcileTrackingIssue opens or updates the repo's security SLA tracking
+// issue for events without PR context (workflow_dispatch, push).
+// It edits the existing open issue carrying securitySLALabel in place of
+// creating a new one each run, and closes it once nothing is breached.
+func (a *Action) reconcileTrackingIssue(ctx context.Context, owner, name string, breached []*Alert, bySeverity map[Severity]int) error {
+	existing, err := findOpenTrackingIssue(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if len(breached) == 0 {
+		if existing == nil {
+			return nil
+		}
+
+		_, _, err := ghClient.Issues.Edit(ctx, owner, name, existing.GetNumber(), &github.IssueRequest{
+			State: github.String("closed"),
+		})
+		return err
+	}
+
+	body := trackingIssueBody(breached, bySeverity)
+
+	if existing != nil {
+		_, _, err := ghClient.Issues.Edit(ctx, owner, name, existing.GetNumber(), &github.IssueRequest{
+			Body: github.String(body),
+		})
+		return err
+	}
+
+	_, _, err = ghClient.Issues.Create(ctx, owner, name, &github.IssueRequest{
+		Title:     github.String(trackingIssueTitle),
+		Body:      github.String(body),
+		Labels:    &[]string{securitySLALabel},
+		Assignees: &a.Assignees,
+	})
+	return err
+}
+
+// findOpenTrackingIssue looks for an existing open issue carrying
+// securitySLALabel and trackingIssueTitle so reconcileTrackingIssue can
+// edit it instead of creating a duplicate on every run. The GitHub issues
+// API also returns pull requests carrying the label, so those are
+// filtered out explicitly.
+func findOpenTrackingIssue(ctx context.Context, owner, name string) (*github.Issue, error) {
+	issues, _, err := ghClient.Issues.ListByRepo(ctx, owner, name, &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{securitySLALabel},
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+		if issue.GetPullRequestLinks() != nil {
+			continue
+		}
+		if issue.GetTitle() != trackingIssueTitle {
+			continue
+		}
+		return issue, nil
+	}
+
+	return nil, nil
+}
+
+var severityHeadings = map[Severity]string{
+	SeverityCritical: "Critical",
+	SeverityHigh:     "High",
+	SeverityMedium:   "Medium",
+	SeverityLow:      "Low",
+}
+
+// trackingIssueBody renders the tracking issue body as breached alerts
+// grouped by severity, each linking back to its GitHub alert page.
+func trackingIssueBody(breached []*Alert, bySeverity map[Severity]int) string {
+	var sb strings.Builder
+	sb.WriteString("The following security alerts are breaching the security SLA:\n\n")
+	sb.WriteString(severityBreakdownTable(bySeverity))
+
+	for _, sev := range []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow} {
+		var group []*Alert
+		for _, al := range breached {
+			if al.Severity == sev {
+				group = append(group, al)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n### %s\n\n", severityHeadings[sev])
+		for _, al := range group {
+			fmt.Fprintf(&sb, "- [%s alert](%s)\n", al.Kind, al.Link)
+		}
+	}
+
+	return sb.String()
+}
+
 func isDisabledError(err error) bool {
 	resp, ok := err.(*github.ErrorResponse)
 	if ok && strings.Contains(resp.Message, "disabled") {
@@ -302,3 +782,125 @@ func isDisabledError(err error) bool {
 	}
 	return false
 }
+
+// AlertFilter decides whether an alert should be excluded from SLA
+// enforcement entirely, before thresholds are even considered. Each rule
+// is its own implementation composed into a chain by applyAlertFilters,
+// so new filters can be added without touching Run.
+type AlertFilter interface {
+	// Skip reports whether the alert should be excluded and, if so, a
+	// short name for the rule that excluded it, for debug logging.
+	Skip(a *Alert) (skip bool, rule string)
+}
+
+// dismissedFilter skips Dependabot alerts GitHub has auto-dismissed.
+type dismissedFilter struct {
+	enabled bool
+}
+
+func (f dismissedFilter) Skip(a *Alert) (bool, string) {
+	if f.enabled && a.Dismissed {
+		return true, "ignore-dismissed"
+	}
+	return false, ""
+}
+
+// toolFilter skips CodeScanning alerts from a named tool, per
+// `ignore-tools`.
+type toolFilter struct {
+	tools map[string]bool
+}
+
+func newToolFilter(raw []string) toolFilter {
+	tools := make(map[string]bool, len(raw))
+	for _, t := range raw {
+		tools[strings.ToLower(strings.TrimSpace(t))] = true
+	}
+	return toolFilter{tools: tools}
+}
+
+func (f toolFilter) Skip(a *Alert) (bool, string) {
+	if a.Tool != "" && f.tools[strings.ToLower(a.Tool)] {
+		return true, "ignore-tools"
+	}
+	return false, ""
+}
+
+// pathFilter skips CodeScanning alerts whose most recent instance location
+// matches a glob in `ignore-paths`.
+type pathFilter struct {
+	globs []string
+}
+
+func (f pathFilter) Skip(a *Alert) (bool, string) {
+	if a.Path == "" {
+		return false, ""
+	}
+	for _, g := range f.globs {
+		if ok, _ := path.Match(g, a.Path); ok {
+			return true, "ignore-paths"
+		}
+	}
+	return false, ""
+}
+
+// ghsaFilter skips Dependabot alerts whose advisory GHSA ID is listed in
+// `ignore-ghsa-ids`.
+type ghsaFilter struct {
+	ids map[string]bool
+}
+
+func newGHSAFilter(raw []string) ghsaFilter {
+	ids := make(map[string]bool, len(raw))
+	for _, id := range raw {
+		ids[strings.ToUpper(strings.TrimSpace(id))] = true
+	}
+	return ghsaFilter{ids: ids}
+}
+
+func (f ghsaFilter) Skip(a *Alert) (bool, string) {
+	if a.GHSAID != "" && f.ids[strings.ToUpper(a.GHSAID)] {
+		return true, "ignore-ghsa-ids"
+	}
+	return false, ""
+}
+
+// minAgeFilter skips alerts younger than `min-age-days`, giving developers
+// a grace window on freshly introduced findings.
+type minAgeFilter struct {
+	minAge time.Duration
+}
+
+func (f minAgeFilter) Skip(a *Alert) (bool, string) {
+	if f.minAge > 0 && time.Since(a.CreatedAt) < f.minAge {
+		return true, "min-age-days"
+	}
+	return false, ""
+}
+
+// applyAlertFilters drops alerts matched by any filter in the chain,
+// logging which rule excluded each one.
+func applyAlertFilters(alerts []*Alert, filters []AlertFilter) []*Alert {
+	kept := make([]*Alert, 0, len(alerts))
+
+	for _, a := range alerts {
+		skipped := false
+
+		for _, f := range filters {
+			if skip, rule := f.Skip(a); skip {
+				slog.Debug("Skipping alert",
+					slog.String("kind", a.Kind),
+					slog.String("link", a.Link),
+					slog.String("rule", rule))
+				skipped = true
+				break
+			}
+		}
+
+		if !skipped {
+			kept = append(kept, a)
+		}
+	}
+
+	return kept
+}
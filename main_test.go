@@ -0,0 +1,165 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeSeverity(t *testing.T) {
+	cases := map[string]Severity{
+		"critical": SeverityCritical,
+		"CRITICAL": SeverityCritical,
+		"high":     SeverityHigh,
+		"error":    SeverityHigh,
+		"medium":   SeverityMedium,
+		"moderate": SeverityMedium,
+		"warning":  SeverityMedium,
+		"low":      SeverityLow,
+		"note":     SeverityLow,
+		"":         SeverityLow,
+	}
+
+	for raw, want := range cases {
+		if got := normalizeSeverity(raw); got != want {
+			t.Errorf("normalizeSeverity(%q) = %s, want %s", raw, got, want)
+		}
+	}
+}
+
+func testSLAConfig() slaConfig {
+	return slaConfig{
+		criticalThreshold:     1 * 24 * time.Hour,
+		highThreshold:         3 * 24 * time.Hour,
+		mediumThreshold:       7 * 24 * time.Hour,
+		lowThreshold:          14 * 24 * time.Hour,
+		cvssCriticalThreshold: 1 * 24 * time.Hour,
+		cvssHighThreshold:     3 * 24 * time.Hour,
+		cweOverrides: map[string]time.Duration{
+			"CWE-798": 1 * 24 * time.Hour,
+		},
+	}
+}
+
+func TestFilterBreachedAlerts(t *testing.T) {
+	cfg := testSLAConfig()
+	now := time.Now()
+	alerts := []*Alert{
+		{Kind: "a", Severity: SeverityCritical, CreatedAt: now.Add(-2 * 24 * time.Hour)}, // breaches critical, within high
+		{Kind: "b", Severity: SeverityHigh, CreatedAt: now.Add(-2 * 24 * time.Hour)},     // within high threshold
+		{Kind: "c", Severity: SeverityHigh, CreatedAt: now.Add(-4 * 24 * time.Hour)},     // breaches high
+		{Kind: "d", Severity: SeverityMedium, CreatedAt: now.Add(-5 * 24 * time.Hour)},   // within medium threshold
+		{Kind: "e", Severity: SeverityLow, CreatedAt: now.Add(-10 * 24 * time.Hour)},     // within low threshold
+	}
+
+	breached := filterBreachedAlerts(alerts, cfg)
+	if len(breached) != 2 {
+		t.Fatalf("expected 2 breached alerts, got %d", len(breached))
+	}
+	if breached[0].Kind != "a" || breached[1].Kind != "c" {
+		t.Errorf("unexpected breached alerts: %+v", breached)
+	}
+}
+
+func TestThresholdForAlertPrecedence(t *testing.T) {
+	cfg := testSLAConfig()
+
+	cases := []struct {
+		name string
+		a    *Alert
+		want time.Duration
+	}{
+		{
+			name: "cwe override wins over severity and CVSS",
+			a:    &Alert{Severity: SeverityLow, CVSSScore: 2.0, CWEs: []string{"CWE-798"}},
+			want: cfg.cweOverrides["CWE-798"],
+		},
+		{
+			name: "cvss critical band wins over severity label",
+			a:    &Alert{Severity: SeverityLow, CVSSScore: 9.8},
+			want: cfg.cvssCriticalThreshold,
+		},
+		{
+			name: "cvss high band wins over severity label",
+			a:    &Alert{Severity: SeverityLow, CVSSScore: 7.2},
+			want: cfg.cvssHighThreshold,
+		},
+		{
+			name: "falls through to severity label when no CVSS or CWE match",
+			a:    &Alert{Severity: SeverityMedium},
+			want: cfg.mediumThreshold,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := thresholdForAlert(tc.a, cfg); got != tc.want {
+				t.Errorf("thresholdForAlert() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestThresholdForAlertCVSSThresholdsUnset(t *testing.T) {
+	cfg := testSLAConfig()
+	cfg.cvssCriticalThreshold = 0
+	cfg.cvssHighThreshold = 0
+
+	a := &Alert{Severity: SeverityMedium, CVSSScore: 9.8}
+
+	if got := thresholdForAlert(a, cfg); got != cfg.mediumThreshold {
+		t.Errorf("thresholdForAlert() = %s, want severity bucket threshold %s", got, cfg.mediumThreshold)
+	}
+}
+
+func TestParseCWEOverrides(t *testing.T) {
+	overrides, err := parseCWEOverrides("CWE-798: 1\nCWE-89: 2\n")
+	if err != nil {
+		t.Fatalf("parseCWEOverrides() error: %v", err)
+	}
+	if overrides["CWE-798"] != 1*24*time.Hour || overrides["CWE-89"] != 2*24*time.Hour {
+		t.Errorf("unexpected overrides: %+v", overrides)
+	}
+
+	if overrides, err := parseCWEOverrides(""); err != nil || overrides != nil {
+		t.Errorf("expected nil, nil for empty input, got %+v, %v", overrides, err)
+	}
+}
+
+func TestApplyAlertFilters(t *testing.T) {
+	now := time.Now()
+	alerts := []*Alert{
+		{Kind: "keep", CreatedAt: now.Add(-10 * 24 * time.Hour)},
+		{Kind: "dismissed", CreatedAt: now.Add(-10 * 24 * time.Hour), Dismissed: true},
+		{Kind: "tool", CreatedAt: now.Add(-10 * 24 * time.Hour), Tool: "CodeQL"},
+		{Kind: "path", CreatedAt: now.Add(-10 * 24 * time.Hour), Path: "vendor/lib.go"},
+		{Kind: "ghsa", CreatedAt: now.Add(-10 * 24 * time.Hour), GHSAID: "GHSA-xxxx-yyyy-zzzz"},
+		{Kind: "too-young", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+
+	filters := []AlertFilter{
+		dismissedFilter{enabled: true},
+		newToolFilter([]string{"codeql"}),
+		pathFilter{globs: []string{"vendor/*"}},
+		newGHSAFilter([]string{"GHSA-xxxx-yyyy-zzzz"}),
+		minAgeFilter{minAge: 2 * 24 * time.Hour},
+	}
+
+	kept := applyAlertFilters(alerts, filters)
+	if len(kept) != 1 || kept[0].Kind != "keep" {
+		t.Errorf("expected only the unfiltered alert to remain, got %+v", kept)
+	}
+}
+
+func TestCountBySeverity(t *testing.T) {
+	alerts := []*Alert{
+		{Severity: SeverityCritical},
+		{Severity: SeverityCritical},
+		{Severity: SeverityHigh},
+		{Severity: SeverityLow},
+	}
+
+	counts := countBySeverity(alerts)
+	if counts[SeverityCritical] != 2 || counts[SeverityHigh] != 1 || counts[SeverityMedium] != 0 || counts[SeverityLow] != 1 {
+		t.Errorf("unexpected counts: %+v", counts)
+	}
+}
@@ -0,0 +1,52 @@
+// Package report defines the stable JSON document this action emits so
+// downstream steps (artifact uploads, Slack notifications, dashboards) can
+// consume a structured summary of an SLA enforcement run instead of
+// scraping free-form check run text.
+package report
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// Report is the top-level document written to the `report-path` input and
+// encoded (compactly, base64) into the `summary` action output.
+type Report struct {
+	Total      int            `json:"total"`
+	Breached   int            `json:"breached"`
+	BySeverity map[string]int `json:"by_severity"`
+	ByKind     map[string]int `json:"by_kind"`
+	Alerts     []Alert        `json:"alerts"`
+}
+
+// Alert is the per-alert entry in a Report.
+type Alert struct {
+	Kind          string    `json:"kind"`
+	Severity      string    `json:"severity"`
+	Link          string    `json:"link"`
+	CreatedAt     time.Time `json:"created_at"`
+	AgeDays       int       `json:"age_days"`
+	ThresholdDays int       `json:"threshold_days"`
+	Breached      bool      `json:"breached"`
+}
+
+// WriteFile marshals the report as indented JSON and writes it to path.
+func (r *Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// CompactBase64 returns the report as compact JSON, base64-encoded, for
+// use as a single-line action output.
+func (r *Report) CompactBase64() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}